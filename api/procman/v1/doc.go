@@ -0,0 +1,9 @@
+// Package procmanv1 contains the generated client and server code for the
+// ProcMan gRPC service defined in procman.proto.
+//
+// Regenerate with:
+//
+//	buf generate api/procman/v1
+package procmanv1
+
+//go:generate buf generate api/procman/v1
@@ -0,0 +1,14 @@
+package goreman
+
+// colors is the palette assigned round-robin to procs in Procfile order so
+// interleaved output stays easy to tell apart in the console.
+var colors = []string{
+	"\033[32m", // green
+	"\033[36m", // cyan
+	"\033[35m", // magenta
+	"\033[33m", // yellow
+	"\033[34m", // blue
+	"\033[31m", // red
+}
+
+const colorReset = "\033[0m"
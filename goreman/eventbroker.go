@@ -0,0 +1,65 @@
+package goreman
+
+import (
+	"sync"
+	"time"
+)
+
+// eventKind mirrors procmanv1.Event_Kind without requiring this file to
+// depend on the generated package.
+type eventKind int
+
+const (
+	eventExited eventKind = iota
+	eventHealthy
+	eventUnhealthy
+	eventRestarted
+)
+
+// procEvent is a single proc lifecycle transition, published to every
+// attached Watch subscriber.
+type procEvent struct {
+	proc   string
+	kind   eventKind
+	ts     time.Time
+	detail string
+}
+
+// eventBroker fans proc lifecycle events out to any number of Watch
+// subscribers, the same way logBroker fans out Tail lines. Publishing
+// never blocks on a slow subscriber.
+type eventBroker struct {
+	mu   sync.Mutex
+	subs map[chan procEvent]struct{}
+}
+
+func newEventBroker() *eventBroker {
+	return &eventBroker{subs: map[chan procEvent]struct{}{}}
+}
+
+func (b *eventBroker) publish(ev procEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (b *eventBroker) subscribe() (<-chan procEvent, func()) {
+	ch := make(chan procEvent, 64)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+}
+
+var globalEventBroker = newEventBroker()
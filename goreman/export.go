@@ -0,0 +1,52 @@
+package goreman
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+var upstartTemplate = template.Must(template.New("upstart").Parse(`description "{{.Name}}"
+
+start on runlevel [2345]
+stop on runlevel [016]
+
+respawn
+
+exec {{.Cmdline}}
+`))
+
+// export writes a unit file per proc for the given format ("upstart" is
+// currently the only one supported) into location. It backs the
+// `goreman export FORMAT LOCATION` command.
+func export(cfg *Config, format, location string) error {
+	if format != "upstart" {
+		return fmt.Errorf("unsupported export format: %s", format)
+	}
+	if err := readProcfile(cfg); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(location, 0o755); err != nil {
+		return err
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	for _, proc := range procs {
+		path := filepath.Join(location, proc.name+".conf")
+		f, err := os.Create(path)
+		if err != nil {
+			return err
+		}
+		err = upstartTemplate.Execute(f, struct {
+			Name    string
+			Cmdline string
+		}{proc.name, proc.cmdline})
+		f.Close()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
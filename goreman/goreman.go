@@ -7,14 +7,19 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"regexp"
 	"runtime"
 	"sort"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
+	"github.com/chaos-plus/procman/goreman/log"
+	"github.com/chaos-plus/procman/service"
 	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
 )
 
 // version is the git tag at the time of build and is used to denote the
@@ -40,6 +45,13 @@ func Usage() {
                                        restart-all
                                        list
                                        status
+                                       tail (with -rpc-protocol=grpc)
+                                       trace
+  goreman health [PROCESS]            # Show healthcheck/backoff state
+  goreman install                    # Register goreman as a boot-time service
+  goreman uninstall                  # Remove the registered service
+  goreman enable                     # Enable the registered service at boot
+  goreman disable                    # Disable the registered service at boot
   goreman start [PROCESS]            # Start the application
   goreman version                    # Display Goreman version
 
@@ -70,7 +82,18 @@ type ProcInfo struct {
 	mu      sync.Mutex
 	cond    *sync.Cond
 	waitErr error
+	exited  bool
 	logTime bool
+
+	// healthcheck and restart policy, parsed from the classic Procfile line
+	// or a Procfile.yaml entry. healthcheck.kind is healthNone when unset.
+	healthcheck healthcheck
+	interval    time.Duration
+	timeout     time.Duration
+	retries     int
+	startPeriod time.Duration
+	restart     restartPolicy
+	health      healthState
 }
 
 var mu sync.Mutex
@@ -94,6 +117,11 @@ type Config struct {
 	ExitOnStop     bool   `yaml:"exit_on_stop" mapstructure:"exit_on_stop" description:"exit on stop" default:"true"`
 	SetPorts       bool   `yaml:"set_ports" mapstructure:"set_ports" description:"False to avoid setting PORT env var for each subprocess" default:"true"`
 	LogTime        bool   `yaml:"logtime" mapstructure:"logtime" description:"show timestamp in log" default:"true"`
+	Subreaper      bool   `yaml:"subreaper" mapstructure:"subreaper" description:"become a child subreaper to catch orphaned grandchildren (Linux only)" default:"true"`
+	ServiceSystem  bool   `yaml:"service_system" mapstructure:"service_system" description:"install/enable/disable the service system-wide instead of per-user" default:"false"`
+	RpcProtocol    string `yaml:"rpc_protocol" mapstructure:"rpc_protocol" description:"which control plane to serve: legacy, grpc or both" default:"legacy"`
+	LogFormat      string `yaml:"log_format" mapstructure:"log_format" description:"console, json or logfmt" default:"console"`
+	Trace          string `yaml:"trace" mapstructure:"trace" description:"comma-separated facets to enable debug logging for, e.g. rpc,supervisor,health" default:""`
 }
 
 // read Procfile and parse it.
@@ -140,9 +168,184 @@ func readProcfile(cfg *Config) error {
 	if len(procs) == 0 {
 		return errors.New("no valid entry")
 	}
+	if err := applyProcfileYAML(cfg); err != nil {
+		return err
+	}
+	return nil
+}
+
+// procYAMLEntry is one entry of a Procfile.yaml sidecar, giving a classic
+// Procfile entry a healthcheck and restart policy without having to encode
+// either in the plain `name: cmd` line.
+type procYAMLEntry struct {
+	Healthcheck string `yaml:"healthcheck"`
+	Interval    string `yaml:"interval"`
+	Timeout     string `yaml:"timeout"`
+	Retries     int    `yaml:"retries"`
+	StartPeriod string `yaml:"start_period"`
+	Restart     string `yaml:"restart"`
+}
+
+const (
+	defaultHealthInterval = 10 * time.Second
+	defaultHealthTimeout  = 5 * time.Second
+	defaultHealthRetries  = 3
+)
+
+// applyProcfileYAML looks for a `<Procfile>.yaml` sidecar next to cfg.Procfile
+// and, for every entry it finds, attaches the declared healthcheck and
+// restart policy to the matching ProcInfo. A sidecar is optional; its
+// absence leaves every proc without a healthcheck and restart: no.
+func applyProcfileYAML(cfg *Config) error {
+	path := cfg.Procfile + ".yaml"
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	var entries map[string]procYAMLEntry
+	if err := yaml.Unmarshal(content, &entries); err != nil {
+		return fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	for name, e := range entries {
+		// readProcfile already holds mu, so the table is searched directly:
+		// findProc would take mu again and deadlock.
+		var proc *ProcInfo
+		for _, p := range procs {
+			if p.name == name {
+				proc = p
+				break
+			}
+		}
+		if proc == nil {
+			return fmt.Errorf("%s: unknown proc %q", path, name)
+		}
+		if e.Healthcheck != "" {
+			hc, err := parseHealthcheck(e.Healthcheck)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %w", path, name, err)
+			}
+			proc.healthcheck = hc
+		}
+		proc.interval = defaultHealthInterval
+		if e.Interval != "" {
+			if proc.interval, err = time.ParseDuration(e.Interval); err != nil {
+				return fmt.Errorf("%s: %s: interval: %w", path, name, err)
+			}
+		}
+		proc.timeout = defaultHealthTimeout
+		if e.Timeout != "" {
+			if proc.timeout, err = time.ParseDuration(e.Timeout); err != nil {
+				return fmt.Errorf("%s: %s: timeout: %w", path, name, err)
+			}
+		}
+		proc.retries = defaultHealthRetries
+		if e.Retries != 0 {
+			proc.retries = e.Retries
+		}
+		if e.StartPeriod != "" {
+			if proc.startPeriod, err = time.ParseDuration(e.StartPeriod); err != nil {
+				return fmt.Errorf("%s: %s: start_period: %w", path, name, err)
+			}
+		}
+		rp, err := parseRestartPolicy(e.Restart)
+		if err != nil {
+			return fmt.Errorf("%s: %s: %w", path, name, err)
+		}
+		proc.restart = rp
+	}
 	return nil
 }
 
+// health prints the current health/backoff state of every proc, or of a
+// single named proc when args names one. Backs the `goreman health` command.
+func health(cfg *Config, args []string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	targets := procs
+	if len(args) > 0 {
+		// mu is already held, so the table is searched directly: findProc
+		// would take mu again and deadlock.
+		var proc *ProcInfo
+		for _, p := range procs {
+			if p.name == args[0] {
+				proc = p
+				break
+			}
+		}
+		if proc == nil {
+			return errors.New("unknown proc: " + args[0])
+		}
+		targets = []*ProcInfo{proc}
+	}
+	for _, proc := range targets {
+		proc.mu.Lock()
+		status := "healthy"
+		if proc.healthcheck.kind == healthNone {
+			status = "no healthcheck"
+		} else if !proc.health.healthy {
+			status = "unhealthy"
+		}
+		fmt.Printf("%-*s %s (backoff=%s)\n", maxProcNameLength, proc.name, status, proc.health.backoff)
+		proc.mu.Unlock()
+	}
+	return nil
+}
+
+// serviceSpec builds the service.Spec that describes how goreman should be
+// registered with the platform's native service manager, from cfg and the
+// currently running executable.
+func serviceSpec(cfg *Config) (service.Spec, error) {
+	procfile, err := filepath.Abs(cfg.Procfile)
+	if err != nil {
+		return service.Spec{}, err
+	}
+	workDir, err := os.Getwd()
+	if err != nil {
+		return service.Spec{}, err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return service.Spec{}, err
+	}
+	return service.Spec{
+		Name:       "goreman-" + strings.TrimSuffix(filepath.Base(procfile), filepath.Ext(procfile)),
+		Procfile:   procfile,
+		WorkDir:    workDir,
+		RpcPort:    cfg.RpcPort,
+		Executable: exe,
+		User:       !cfg.ServiceSystem,
+	}, nil
+}
+
+// install registers goreman as a native, boot-time service for the given
+// Procfile. It backs the `goreman install`, `uninstall`, `enable` and
+// `disable` commands.
+func install(cfg *Config, action string) error {
+	spec, err := serviceSpec(cfg)
+	if err != nil {
+		return err
+	}
+	backend := service.Default()
+	switch action {
+	case "install":
+		return backend.Install(spec)
+	case "uninstall":
+		return backend.Uninstall(spec)
+	case "enable":
+		return backend.Enable(spec)
+	case "disable":
+		return backend.Disable(spec)
+	default:
+		return fmt.Errorf("unknown service action %q", action)
+	}
+}
+
 func defaultServer(serverPort uint) string {
 	if s, ok := os.LookupEnv("GOREMAN_RPC_SERVER"); ok {
 		return s
@@ -204,6 +407,9 @@ func findProc(name string) *ProcInfo {
 
 // command: start. spawn procs.
 func start(ctx context.Context, sig <-chan os.Signal, cfg *Config) error {
+	log.SetFormat(log.Format(cfg.LogFormat))
+	log.SetFacets(cfg.Trace)
+
 	err := readProcfile(cfg)
 	if err != nil {
 		return err
@@ -230,11 +436,25 @@ func start(ctx context.Context, sig <-chan os.Signal, cfg *Config) error {
 		procs = tmp
 		mu.Unlock()
 	}
+	if err := startSubreaper(ctx, cfg); err != nil {
+		return err
+	}
 	godotenv.Load()
 	rpcChan := make(chan *rpcMessage, 10)
-	if cfg.StartRpcServer {
+	if cfg.StartRpcServer && cfg.RpcProtocol != "grpc" {
 		go startServer(ctx, rpcChan, cfg.RpcPort)
 	}
+	if cfg.StartRpcServer && (cfg.RpcProtocol == "grpc" || cfg.RpcProtocol == "both") {
+		grpcPort := cfg.RpcPort
+		if cfg.RpcProtocol == "both" {
+			grpcPort++
+		}
+		go func() {
+			if err := startGRPCServer(ctx, fmt.Sprintf("127.0.0.1:%d", grpcPort)); err != nil {
+				fmt.Fprintf(os.Stderr, "goreman: grpc server: %v\n", err)
+			}
+		}()
+	}
 	procsErr := startProcs(ctx, sig, rpcChan, cfg)
 	return procsErr
 }
@@ -255,6 +475,11 @@ func ParseConfigWithFlagSet(fs *flag.FlagSet, args []string) (*Config, error) {
 	fs.BoolVar(&cfg.ExitOnError, "exit-on-error", false, "Exit goreman if a subprocess quits with a nonzero return code")
 	fs.BoolVar(&cfg.ExitOnStop, "exit-on-stop", true, "Exit goreman if all subprocesses stop")
 	fs.BoolVar(&cfg.LogTime, "logtime", true, "show timestamp in log")
+	fs.BoolVar(&cfg.Subreaper, "subreaper", defaultSubreaper, "become a child subreaper to reap orphaned grandchildren (Linux only); disable under tini/systemd")
+	fs.BoolVar(&cfg.ServiceSystem, "system", false, "install/enable/disable the service system-wide instead of per-user")
+	fs.StringVar(&cfg.RpcProtocol, "rpc-protocol", "legacy", "which control plane to serve: legacy, grpc or both")
+	fs.StringVar(&cfg.LogFormat, "log-format", "console", "console, json or logfmt")
+	fs.StringVar(&cfg.Trace, "trace", os.Getenv("PROCMAN_TRACE"), "comma-separated facets to enable debug logging for, e.g. rpc,supervisor,health")
 	fs.Parse(args)
 	if len(fs.Args()) > 0 {
 		cfg.Args = fs.Args()
@@ -292,12 +517,20 @@ func MainWithConfig(cfg *Config) {
 	switch cmd {
 	case "check":
 		err = check(cfg)
+	case "health":
+		err = health(cfg, cfg.Args[1:])
+	case "install", "uninstall", "enable", "disable":
+		err = install(cfg, cmd)
 	case "help":
 		Usage()
 	case "run":
 		if len(cfg.Args) >= 2 {
-			cmd, args := cfg.Args[1], cfg.Args[2:]
-			err = run(cmd, args, cfg.RpcPort)
+			runCmd, args := cfg.Args[1], cfg.Args[2:]
+			if cfg.RpcProtocol == "grpc" {
+				err = runGRPC(runCmd, args, cfg.RpcPort)
+			} else {
+				err = run(runCmd, args, cfg.RpcPort)
+			}
 		} else {
 			Usage()
 		}
@@ -0,0 +1,87 @@
+package goreman
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	procmanv1 "github.com/chaos-plus/procman/api/procman/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// runGRPC is the thin gRPC equivalent of run: it dials the ProcMan service
+// on rpcPort and issues the command named by cmd against args.
+func runGRPC(cmd string, args []string, rpcPort uint) error {
+	addr := fmt.Sprintf("127.0.0.1:%d", rpcPort)
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := procmanv1.NewProcManClient(conn)
+	ctx := context.Background()
+
+	switch cmd {
+	case "list":
+		resp, err := client.List(ctx, &emptypb.Empty{})
+		if err != nil {
+			return err
+		}
+		for _, name := range resp.Names {
+			fmt.Println(name)
+		}
+	case "status":
+		resp, err := client.Status(ctx, &emptypb.Empty{})
+		if err != nil {
+			return err
+		}
+		for _, p := range resp.Procs {
+			fmt.Printf("%-*s running=%v healthy=%v pid=%d\n", maxProcNameLength, p.Name, p.Running, p.Healthy, p.Pid)
+		}
+	case "start":
+		_, err = client.Start(ctx, &procmanv1.ProcRequest{Name: arg0(args)})
+	case "stop":
+		_, err = client.Stop(ctx, &procmanv1.ProcRequest{Name: arg0(args)})
+	case "restart":
+		_, err = client.Restart(ctx, &procmanv1.ProcRequest{Name: arg0(args)})
+	case "stop-all":
+		_, err = client.StopAll(ctx, &emptypb.Empty{})
+	case "restart-all":
+		_, err = client.RestartAll(ctx, &emptypb.Empty{})
+	case "tail":
+		return tailGRPC(ctx, client, args)
+	case "trace":
+		_, err = client.SetTrace(ctx, &procmanv1.TraceRequest{Facets: arg0(args)})
+	default:
+		return fmt.Errorf("unknown run command: %s", cmd)
+	}
+	return err
+}
+
+func arg0(args []string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	return args[0]
+}
+
+func tailGRPC(ctx context.Context, client procmanv1.ProcManClient, names []string) error {
+	stream, err := client.Tail(ctx, &procmanv1.TailRequest{Names: names})
+	if err != nil {
+		return err
+	}
+	for {
+		entry, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "%s %s | %s\n", entry.Proc, entry.Stream, entry.Line)
+	}
+}
@@ -0,0 +1,206 @@
+package goreman
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"syscall"
+	"time"
+
+	procmanv1 "github.com/chaos-plus/procman/api/procman/v1"
+	"github.com/chaos-plus/procman/goreman/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// grpcServer implements the ProcMan gRPC service on top of the same proc
+// table and control primitives the legacy RPC protocol uses.
+type grpcServer struct {
+	procmanv1.UnimplementedProcManServer
+}
+
+// startGRPCServer serves the ProcMan gRPC service on addr until ctx is
+// cancelled. It is started alongside (or instead of) the legacy RPC server
+// depending on Config.RpcProtocol.
+func startGRPCServer(ctx context.Context, addr string) error {
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return fmt.Errorf("grpc: listen %s: %w", addr, err)
+	}
+
+	srv := grpc.NewServer()
+	procmanv1.RegisterProcManServer(srv, &grpcServer{})
+	reflection.Register(srv)
+
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+	return srv.Serve(lis)
+}
+
+func (s *grpcServer) List(ctx context.Context, _ *emptypb.Empty) (*procmanv1.ListResponse, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	names := make([]string, len(procs))
+	for i, proc := range procs {
+		names[i] = proc.name
+	}
+	return &procmanv1.ListResponse{Names: names}, nil
+}
+
+func (s *grpcServer) Status(ctx context.Context, _ *emptypb.Empty) (*procmanv1.StatusResponse, error) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	resp := &procmanv1.StatusResponse{Procs: make([]*procmanv1.ProcStatus, len(procs))}
+	for i, proc := range procs {
+		proc.mu.Lock()
+		running := proc.cmd != nil && proc.cmd.Process != nil && !proc.exited
+		pid := 0
+		if proc.cmd != nil && proc.cmd.Process != nil {
+			pid = proc.cmd.Process.Pid
+		}
+		resp.Procs[i] = &procmanv1.ProcStatus{
+			Name:    proc.name,
+			Running: running,
+			Healthy: proc.health.healthy,
+			Pid:     int32(pid),
+		}
+		proc.mu.Unlock()
+	}
+	return resp, nil
+}
+
+func (s *grpcServer) Start(ctx context.Context, req *procmanv1.ProcRequest) (*emptypb.Empty, error) {
+	proc := findProc(req.Name)
+	if proc == nil {
+		return nil, fmt.Errorf("unknown proc: %s", req.Name)
+	}
+	return &emptypb.Empty{}, startProc(proc)
+}
+
+func (s *grpcServer) Stop(ctx context.Context, req *procmanv1.ProcRequest) (*emptypb.Empty, error) {
+	proc := findProc(req.Name)
+	if proc == nil {
+		return nil, fmt.Errorf("unknown proc: %s", req.Name)
+	}
+	return &emptypb.Empty{}, stopProc(proc, syscall.SIGTERM)
+}
+
+func (s *grpcServer) Restart(ctx context.Context, req *procmanv1.ProcRequest) (*emptypb.Empty, error) {
+	proc := findProc(req.Name)
+	if proc == nil {
+		return nil, fmt.Errorf("unknown proc: %s", req.Name)
+	}
+	return &emptypb.Empty{}, restartProc(proc, syscall.SIGTERM)
+}
+
+func (s *grpcServer) StopAll(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	mu.Lock()
+	targets := append([]*ProcInfo{}, procs...)
+	mu.Unlock()
+
+	for _, proc := range targets {
+		if err := stopProc(proc, syscall.SIGTERM); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcServer) RestartAll(ctx context.Context, _ *emptypb.Empty) (*emptypb.Empty, error) {
+	mu.Lock()
+	targets := append([]*ProcInfo{}, procs...)
+	mu.Unlock()
+
+	for _, proc := range targets {
+		if err := restartProc(proc, syscall.SIGTERM); err != nil {
+			return nil, err
+		}
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *grpcServer) Tail(req *procmanv1.TailRequest, stream procmanv1.ProcMan_TailServer) error {
+	want := map[string]bool{}
+	for _, n := range req.Names {
+		want[n] = true
+	}
+
+	ch, unsubscribe := globalLogBroker.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case line, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if len(want) > 0 && !want[line.proc] {
+				continue
+			}
+			err := stream.Send(&procmanv1.LogEntry{
+				Proc:   line.proc,
+				Stream: line.stream,
+				Ts:     timestamppb.New(time.Now()),
+				Line:   line.line,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *grpcServer) Watch(_ *emptypb.Empty, stream procmanv1.ProcMan_WatchServer) error {
+	ch, unsubscribe := globalEventBroker.subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case ev, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			err := stream.Send(&procmanv1.Event{
+				Proc:   ev.proc,
+				Kind:   eventKindToProto(ev.kind),
+				Ts:     timestamppb.New(ev.ts),
+				Detail: ev.detail,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// eventKindToProto maps goreman's internal eventKind to the generated
+// procmanv1.Event_Kind enum.
+func eventKindToProto(k eventKind) procmanv1.Event_Kind {
+	switch k {
+	case eventExited:
+		return procmanv1.Event_EXITED
+	case eventHealthy:
+		return procmanv1.Event_HEALTHY
+	case eventUnhealthy:
+		return procmanv1.Event_UNHEALTHY
+	case eventRestarted:
+		return procmanv1.Event_RESTARTED
+	default:
+		return procmanv1.Event_UNKNOWN
+	}
+}
+
+func (s *grpcServer) SetTrace(ctx context.Context, req *procmanv1.TraceRequest) (*emptypb.Empty, error) {
+	log.SetFacets(req.Facets)
+	return &emptypb.Empty{}, nil
+}
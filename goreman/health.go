@@ -0,0 +1,237 @@
+package goreman
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/chaos-plus/procman/goreman/log"
+)
+
+// healthKind identifies which probe a healthcheck line runs.
+type healthKind int
+
+const (
+	healthNone healthKind = iota
+	healthCmd
+	healthHTTP
+	healthTCP
+)
+
+// healthcheck is the parsed form of a Procfile.yaml `healthcheck` entry,
+// e.g. "cmd:pg_isready -h localhost", "http:http://localhost:8080/healthz" or
+// "tcp:localhost:5432".
+type healthcheck struct {
+	kind healthKind
+	arg  string
+}
+
+// restartKind is the parsed form of a Procfile.yaml `restart` entry.
+type restartKind int
+
+const (
+	restartNo restartKind = iota
+	restartOnFailure
+	restartAlways
+	restartUnlessStopped
+)
+
+// restartPolicy controls whether and how a proc is respawned after it
+// exits, and how aggressively a failing healthcheck kills and restarts it.
+type restartPolicy struct {
+	kind       restartKind
+	maxRetries int // 0 means unlimited, only meaningful for restartOnFailure
+}
+
+const (
+	backoffInitial = 1 * time.Second
+	backoffCap     = 60 * time.Second
+)
+
+// parseHealthcheck parses the value of a `healthcheck:` key from
+// Procfile.yaml into a healthcheck, e.g. "cmd:<shell>", "http:<url>" or
+// "tcp:<host:port>".
+func parseHealthcheck(s string) (healthcheck, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return healthcheck{kind: healthNone}, nil
+	}
+	kind, arg, ok := strings.Cut(s, ":")
+	if !ok {
+		return healthcheck{}, fmt.Errorf("healthcheck must be of the form cmd|http|tcp:<arg>, got %q", s)
+	}
+	switch kind {
+	case "cmd":
+		return healthcheck{kind: healthCmd, arg: arg}, nil
+	case "http":
+		return healthcheck{kind: healthHTTP, arg: arg}, nil
+	case "tcp":
+		return healthcheck{kind: healthTCP, arg: arg}, nil
+	default:
+		return healthcheck{}, fmt.Errorf("unknown healthcheck kind %q", kind)
+	}
+}
+
+// parseRestartPolicy parses the value of a `restart:` key from
+// Procfile.yaml, e.g. "no", "always", "unless-stopped" or
+// "on-failure[:max]".
+func parseRestartPolicy(s string) (restartPolicy, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return restartPolicy{kind: restartNo}, nil
+	}
+	kind, rest, _ := strings.Cut(s, ":")
+	switch kind {
+	case "no":
+		return restartPolicy{kind: restartNo}, nil
+	case "always":
+		return restartPolicy{kind: restartAlways}, nil
+	case "unless-stopped":
+		return restartPolicy{kind: restartUnlessStopped}, nil
+	case "on-failure":
+		p := restartPolicy{kind: restartOnFailure}
+		if rest != "" {
+			n, err := fmt.Sscanf(rest, "%d", &p.maxRetries)
+			if err != nil || n != 1 {
+				return restartPolicy{}, fmt.Errorf("invalid on-failure max retries %q", rest)
+			}
+		}
+		return p, nil
+	default:
+		return restartPolicy{}, fmt.Errorf("unknown restart policy %q", s)
+	}
+}
+
+// run executes the probe once and reports whether the proc is healthy.
+func (h healthcheck) run(ctx context.Context, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch h.kind {
+	case healthNone:
+		return nil
+	case healthCmd:
+		cmd := exec.CommandContext(ctx, "sh", "-c", h.arg)
+		return cmd.Run()
+	case healthHTTP:
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, h.arg, nil)
+		if err != nil {
+			return err
+		}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("healthcheck %s: status %d", h.arg, resp.StatusCode)
+		}
+		return nil
+	case healthTCP:
+		d := net.Dialer{}
+		conn, err := d.DialContext(ctx, "tcp", h.arg)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	default:
+		return fmt.Errorf("unknown healthcheck kind %d", h.kind)
+	}
+}
+
+// healthState is the live, mutable health/backoff tracking for a single
+// proc, surfaced through the `status` RPC and `goreman health`. All fields
+// are guarded by the owning ProcInfo's mu.
+type healthState struct {
+	healthy          bool
+	consecutiveFails int
+	backoff          time.Duration
+	restartedAt      time.Time
+	restartAttempts  int
+}
+
+// nextBackoff advances and returns the backoff duration to wait before the
+// next respawn, capping at backoffCap. Caller must hold the owning
+// ProcInfo's mu.
+func (s *healthState) nextBackoff() time.Duration {
+	if s.backoff == 0 {
+		s.backoff = backoffInitial
+	} else {
+		s.backoff *= 2
+		if s.backoff > backoffCap {
+			s.backoff = backoffCap
+		}
+	}
+	return s.backoff
+}
+
+// resetBackoff is called once a proc has run healthily for its startPeriod.
+// Caller must hold the owning ProcInfo's mu.
+func (s *healthState) resetBackoff() {
+	s.backoff = 0
+	s.consecutiveFails = 0
+	s.restartAttempts = 0
+}
+
+// superviseHealth runs the healthcheck for proc on its configured interval
+// until ctx is cancelled, marking it unhealthy after `retries` consecutive
+// failures. A restart policy of "no" only logs the failure; any other
+// policy kills the proc via onKill so proc.go's exit monitor can consult
+// the policy and respawn it.
+func superviseHealth(ctx context.Context, proc *ProcInfo, state *healthState, onKill func()) {
+	if proc.healthcheck.kind == healthNone {
+		return
+	}
+	logger := log.New(proc.name, "health", proc.colorIndex)
+	ticker := time.NewTicker(proc.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			err := proc.healthcheck.run(ctx, proc.timeout)
+
+			proc.mu.Lock()
+			if err == nil {
+				wasHealthy := state.healthy
+				state.healthy = true
+				state.consecutiveFails = 0
+				if proc.startPeriod > 0 && time.Since(state.restartedAt) >= proc.startPeriod {
+					state.resetBackoff()
+				}
+				proc.mu.Unlock()
+				if !wasHealthy {
+					logger.Infof("healthcheck passing again")
+					globalEventBroker.publish(procEvent{proc: proc.name, kind: eventHealthy, ts: time.Now()})
+				} else {
+					logger.Debugf("healthcheck ok")
+				}
+				continue
+			}
+
+			state.consecutiveFails++
+			logger.Debugf("healthcheck failed (%d/%d): %v", state.consecutiveFails, proc.retries, err)
+			if state.consecutiveFails < proc.retries {
+				proc.mu.Unlock()
+				continue
+			}
+			state.healthy = false
+			proc.mu.Unlock()
+
+			logger.Warnf("unhealthy after %d consecutive failures: %v", state.consecutiveFails, err)
+			globalEventBroker.publish(procEvent{proc: proc.name, kind: eventUnhealthy, ts: time.Now(), detail: err.Error()})
+
+			if proc.restart.kind == restartNo {
+				logger.Infof("restart policy is \"no\"; leaving unhealthy proc running")
+				continue
+			}
+			onKill()
+		}
+	}
+}
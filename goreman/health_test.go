@@ -0,0 +1,140 @@
+package goreman
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestParseHealthcheck(t *testing.T) {
+	cases := []struct {
+		in      string
+		wantOK  bool
+		wantKnd healthKind
+		wantArg string
+	}{
+		{"", true, healthNone, ""},
+		{"cmd:pg_isready -h localhost", true, healthCmd, "pg_isready -h localhost"},
+		{"http://localhost:8080/healthz", true, healthHTTP, "//localhost:8080/healthz"},
+		{"tcp:localhost:5432", true, healthTCP, "localhost:5432"},
+		{"bogus", false, 0, ""},
+	}
+	for _, c := range cases {
+		got, err := parseHealthcheck(c.in)
+		if c.wantOK && err != nil {
+			t.Errorf("parseHealthcheck(%q): unexpected error: %v", c.in, err)
+			continue
+		}
+		if !c.wantOK {
+			if err == nil {
+				t.Errorf("parseHealthcheck(%q): expected error, got none", c.in)
+			}
+			continue
+		}
+		if got.kind != c.wantKnd || got.arg != c.wantArg {
+			t.Errorf("parseHealthcheck(%q) = %+v, want kind=%v arg=%q", c.in, got, c.wantKnd, c.wantArg)
+		}
+	}
+}
+
+func TestParseRestartPolicy(t *testing.T) {
+	cases := []struct {
+		in          string
+		wantOK      bool
+		wantKind    restartKind
+		wantRetries int
+	}{
+		{"", true, restartNo, 0},
+		{"no", true, restartNo, 0},
+		{"always", true, restartAlways, 0},
+		{"unless-stopped", true, restartUnlessStopped, 0},
+		{"on-failure", true, restartOnFailure, 0},
+		{"on-failure:5", true, restartOnFailure, 5},
+		{"bogus", false, 0, 0},
+		{"on-failure:nope", false, 0, 0},
+	}
+	for _, c := range cases {
+		got, err := parseRestartPolicy(c.in)
+		if c.wantOK && err != nil {
+			t.Errorf("parseRestartPolicy(%q): unexpected error: %v", c.in, err)
+			continue
+		}
+		if !c.wantOK {
+			if err == nil {
+				t.Errorf("parseRestartPolicy(%q): expected error, got none", c.in)
+			}
+			continue
+		}
+		if got.kind != c.wantKind || got.maxRetries != c.wantRetries {
+			t.Errorf("parseRestartPolicy(%q) = %+v, want kind=%v retries=%d", c.in, got, c.wantKind, c.wantRetries)
+		}
+	}
+}
+
+func TestHealthStateBackoff(t *testing.T) {
+	var s healthState
+
+	if got := s.nextBackoff(); got != backoffInitial {
+		t.Fatalf("first nextBackoff() = %v, want %v", got, backoffInitial)
+	}
+	prev := s.backoff
+	for i := 0; i < 10; i++ {
+		got := s.nextBackoff()
+		if got > backoffCap {
+			t.Fatalf("nextBackoff() exceeded cap: %v > %v", got, backoffCap)
+		}
+		if got < prev {
+			t.Fatalf("nextBackoff() decreased: %v < %v", got, prev)
+		}
+		prev = got
+	}
+	if s.backoff != backoffCap {
+		t.Fatalf("backoff did not converge to cap: got %v, want %v", s.backoff, backoffCap)
+	}
+
+	s.consecutiveFails = 3
+	s.restartAttempts = 2
+	s.resetBackoff()
+	if s.backoff != 0 || s.consecutiveFails != 0 || s.restartAttempts != 0 {
+		t.Fatalf("resetBackoff() left state = %+v, want all zero", s)
+	}
+}
+
+func TestShouldRestart(t *testing.T) {
+	cases := []struct {
+		policy   restartPolicy
+		waitErr  error
+		expected bool
+	}{
+		{restartPolicy{kind: restartNo}, nil, false},
+		{restartPolicy{kind: restartNo}, errBoom, false},
+		{restartPolicy{kind: restartAlways}, nil, true},
+		{restartPolicy{kind: restartAlways}, errBoom, true},
+		{restartPolicy{kind: restartUnlessStopped}, nil, true},
+		{restartPolicy{kind: restartOnFailure}, nil, false},
+		{restartPolicy{kind: restartOnFailure}, errBoom, true},
+	}
+	for _, c := range cases {
+		if got := shouldRestart(c.policy, c.waitErr); got != c.expected {
+			t.Errorf("shouldRestart(%+v, %v) = %v, want %v", c.policy, c.waitErr, got, c.expected)
+		}
+	}
+}
+
+var errBoom = errTest("boom")
+
+type errTest string
+
+func (e errTest) Error() string { return string(e) }
+
+func TestHealthcheckRunTCP(t *testing.T) {
+	hc, err := parseHealthcheck("tcp:127.0.0.1:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Nothing listens on port 1; the dial should fail quickly within the
+	// timeout rather than hang.
+	if err := hc.run(context.Background(), 200*time.Millisecond); err == nil {
+		t.Fatal("expected dial failure against a closed port")
+	}
+}
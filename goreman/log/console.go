@@ -0,0 +1,35 @@
+package log
+
+import (
+	"fmt"
+	"time"
+)
+
+// consoleColors mirrors the palette the interactive `goreman start` console
+// has always used to tell procs apart at a glance.
+var consoleColors = []string{
+	"\033[32m", // green
+	"\033[36m", // cyan
+	"\033[35m", // magenta
+	"\033[33m", // yellow
+	"\033[34m", // blue
+	"\033[31m", // red
+}
+
+const consoleReset = "\033[0m"
+
+// renderConsole preserves the historical human-friendly colored output:
+// the proc name in its assigned color and the raw line. Warn and error
+// lines also carry a timestamp and the level.
+func renderConsole(line Line, colorIndex int) {
+	color := consoleColors[colorIndex%len(consoleColors)]
+	prefix := fmt.Sprintf("%s%s%s |", color, line.Proc, consoleReset)
+	if line.Proc == "" {
+		prefix = "goreman |"
+	}
+	if line.Level >= LevelWarn {
+		fmt.Fprintf(out, "%s %s %s: %s\n", prefix, time.Now().Format("15:04:05"), line.Level, line.Msg)
+		return
+	}
+	fmt.Fprintf(out, "%s %s\n", prefix, line.Msg)
+}
@@ -0,0 +1,179 @@
+// Package log is goreman's structured logger. Every line carries proc,
+// stream, timestamp, level and message, rendered through a selectable
+// Formatter: a human-friendly colored "console" formatter (the historical
+// default) or a machine-parseable "json"/"logfmt" formatter suitable for
+// shipping to Loki/ELK.
+//
+// Debug output is gated per subsystem by a "facet": callers identify
+// themselves (e.g. "rpc", "supervisor", "health") and SetFacets enables or
+// disables debug logging for each, either from the PROCMAN_TRACE env var,
+// a -trace flag, or at runtime over the RPC.
+package log
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level is the severity of a single log line.
+type Level int
+
+const (
+	LevelDebug Level = iota
+	LevelInfo
+	LevelWarn
+	LevelError
+)
+
+func (l Level) String() string {
+	switch l {
+	case LevelDebug:
+		return "debug"
+	case LevelInfo:
+		return "info"
+	case LevelWarn:
+		return "warn"
+	case LevelError:
+		return "error"
+	default:
+		return "unknown"
+	}
+}
+
+// Format selects how a Line is rendered.
+type Format string
+
+const (
+	FormatConsole Format = "console"
+	FormatJSON    Format = "json"
+	FormatLogfmt  Format = "logfmt"
+)
+
+// Line is a single structured log entry.
+type Line struct {
+	Time   time.Time
+	Proc   string
+	Stream string // "stdout", "stderr", or "" for goreman's own logs
+	Level  Level
+	Facet  string
+	Msg    string
+}
+
+var (
+	facetsMu sync.RWMutex
+	facets   = map[string]bool{}
+
+	out    io.Writer = os.Stdout
+	format Format    = FormatConsole
+)
+
+// SetFacets enables debug output only for the named, comma-separated
+// subsystems (e.g. "rpc,supervisor,health"); an empty string disables all
+// facet debug output. It is safe to call at any time, including from an
+// RPC handler flipping trace flags at runtime.
+func SetFacets(csv string) {
+	next := map[string]bool{}
+	for _, f := range strings.Split(csv, ",") {
+		f = strings.TrimSpace(f)
+		if f != "" {
+			next[f] = true
+		}
+	}
+	facetsMu.Lock()
+	facets = next
+	facetsMu.Unlock()
+}
+
+func facetEnabled(facet string) bool {
+	facetsMu.RLock()
+	defer facetsMu.RUnlock()
+	return facets[facet]
+}
+
+// SetFormat selects the Formatter used by every Logger, e.g. "console" for
+// interactive use or "json"/"logfmt" for machine-parseable output.
+func SetFormat(f Format) {
+	format = f
+}
+
+// SetOutput redirects where rendered lines are written. Defaults to
+// os.Stdout.
+func SetOutput(w io.Writer) {
+	out = w
+}
+
+// Logger writes structured lines for a single proc/facet pair.
+type Logger struct {
+	proc       string
+	facet      string
+	colorIndex int
+}
+
+// New returns a Logger that tags every line with proc and facet. proc may
+// be empty for goreman's own (non-proc) logging.
+func New(proc, facet string, colorIndex int) *Logger {
+	return &Logger{proc: proc, facet: facet, colorIndex: colorIndex}
+}
+
+func (l *Logger) emit(level Level, stream, msg string) {
+	render(Line{
+		Time:   time.Now(),
+		Proc:   l.proc,
+		Stream: stream,
+		Level:  level,
+		Facet:  l.facet,
+		Msg:    msg,
+	}, l.colorIndex)
+}
+
+// Debugf logs at debug level. A Logger with a facet only emits once that
+// facet has been enabled via SetFacets; a Logger with no facet always
+// emits.
+func (l *Logger) Debugf(format string, args ...interface{}) {
+	if l.facet != "" && !facetEnabled(l.facet) {
+		return
+	}
+	l.emit(LevelDebug, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Infof(format string, args ...interface{}) {
+	l.emit(LevelInfo, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Warnf(format string, args ...interface{}) {
+	l.emit(LevelWarn, "", fmt.Sprintf(format, args...))
+}
+
+func (l *Logger) Errorf(format string, args ...interface{}) {
+	l.emit(LevelError, "", fmt.Sprintf(format, args...))
+}
+
+// Output writes a raw line of proc output (stdout or stderr) at info
+// level, tagged with the given stream.
+func (l *Logger) Output(stream, line string) {
+	l.emit(LevelInfo, stream, line)
+}
+
+func render(line Line, colorIndex int) {
+	switch format {
+	case FormatJSON:
+		b, _ := json.Marshal(struct {
+			Ts     string `json:"ts"`
+			Proc   string `json:"proc"`
+			Stream string `json:"stream"`
+			Level  string `json:"level"`
+			Msg    string `json:"msg"`
+		}{line.Time.Format(time.RFC3339Nano), line.Proc, line.Stream, line.Level.String(), line.Msg})
+		fmt.Fprintf(out, "%s\n", b)
+	case FormatLogfmt:
+		fmt.Fprintf(out, "ts=%s proc=%q stream=%q level=%s msg=%q\n",
+			line.Time.Format(time.RFC3339Nano), line.Proc, line.Stream, line.Level, line.Msg)
+	default:
+		renderConsole(line, colorIndex)
+	}
+}
@@ -0,0 +1,86 @@
+package log
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func withCapturedOutput(t *testing.T, f Format, fn func(buf *bytes.Buffer)) {
+	t.Helper()
+	var buf bytes.Buffer
+	prevOut, prevFormat := out, format
+	SetOutput(&buf)
+	SetFormat(f)
+	t.Cleanup(func() {
+		SetOutput(prevOut)
+		SetFormat(prevFormat)
+	})
+	fn(&buf)
+}
+
+func TestJSONFormatterIncludesFields(t *testing.T) {
+	withCapturedOutput(t, FormatJSON, func(buf *bytes.Buffer) {
+		l := New("web", "", 0)
+		l.Infof("listening on :8080")
+		got := buf.String()
+		for _, want := range []string{`"proc":"web"`, `"level":"info"`, `"msg":"listening on :8080"`} {
+			if !strings.Contains(got, want) {
+				t.Errorf("json output %q missing %q", got, want)
+			}
+		}
+	})
+}
+
+func TestLogfmtFormatterIncludesFields(t *testing.T) {
+	withCapturedOutput(t, FormatLogfmt, func(buf *bytes.Buffer) {
+		l := New("worker", "", 1)
+		l.Warnf("retrying")
+		got := buf.String()
+		for _, want := range []string{`proc="worker"`, `level=warn`, `msg="retrying"`} {
+			if !strings.Contains(got, want) {
+				t.Errorf("logfmt output %q missing %q", got, want)
+			}
+		}
+	})
+}
+
+func TestConsoleFormatterPreservesHumanReadableLine(t *testing.T) {
+	withCapturedOutput(t, FormatConsole, func(buf *bytes.Buffer) {
+		l := New("web", "", 0)
+		l.Output("stdout", "booted")
+		got := buf.String()
+		if !strings.Contains(got, "web") || !strings.Contains(got, "booted") {
+			t.Errorf("console output %q missing proc name or message", got)
+		}
+	})
+}
+
+func TestDebugfGatedByFacet(t *testing.T) {
+	SetFacets("")
+	withCapturedOutput(t, FormatJSON, func(buf *bytes.Buffer) {
+		l := New("web", "rpc", 0)
+		l.Debugf("should not appear")
+		if buf.Len() != 0 {
+			t.Fatalf("expected no output for a disabled facet, got %q", buf.String())
+		}
+
+		SetFacets("rpc,health")
+		l.Debugf("should appear")
+		if !strings.Contains(buf.String(), "should appear") {
+			t.Fatalf("expected debug output once the facet is enabled, got %q", buf.String())
+		}
+	})
+	SetFacets("")
+}
+
+func TestSetFacetsTrimsWhitespace(t *testing.T) {
+	SetFacets(" rpc , health ")
+	if !facetEnabled("rpc") || !facetEnabled("health") {
+		t.Fatal("expected both facets enabled after trimming whitespace")
+	}
+	if facetEnabled("supervisor") {
+		t.Fatal("expected an unlisted facet to stay disabled")
+	}
+	SetFacets("")
+}
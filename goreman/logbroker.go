@@ -0,0 +1,56 @@
+package goreman
+
+import "sync"
+
+// logLine is one line of proc output, tee'd to every attached Tail
+// subscriber as well as the normal colored console writer.
+type logLine struct {
+	proc   string
+	stream string // "stdout" or "stderr"
+	line   string
+}
+
+// logBroker fans a single stream of logLine out to any number of
+// subscribers (gRPC Tail calls) without them racing on stdout or on each
+// other. Publishing never blocks on a slow subscriber: a subscriber whose
+// channel is full simply misses lines rather than stalling the proc that
+// produced them.
+type logBroker struct {
+	mu   sync.Mutex
+	subs map[chan logLine]struct{}
+}
+
+func newLogBroker() *logBroker {
+	return &logBroker{subs: map[chan logLine]struct{}{}}
+}
+
+// publish tees line to every current subscriber.
+func (b *logBroker) publish(line logLine) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs {
+		select {
+		case ch <- line:
+		default:
+		}
+	}
+}
+
+// subscribe registers a new subscriber and returns its channel and an
+// unsubscribe func the caller must invoke when done (e.g. when the Tail
+// stream's context is cancelled).
+func (b *logBroker) subscribe() (<-chan logLine, func()) {
+	ch := make(chan logLine, 256)
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		b.mu.Unlock()
+		close(ch)
+	}
+}
+
+var globalLogBroker = newLogBroker()
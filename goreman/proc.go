@@ -0,0 +1,272 @@
+package goreman
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"syscall"
+	"time"
+
+	"github.com/chaos-plus/procman/goreman/log"
+)
+
+// currentCfg is the Config for the currently running `goreman start`,
+// needed by startProc so that a respawn triggered by an RPC/gRPC client
+// (which only has a *ProcInfo, not the Config) still honors Subreaper.
+var currentCfg *Config
+
+// spawnProc launches proc's command line, wiring its stdout/stderr through
+// the structured logger and the log fan-out hub, and arranges for its exit
+// to be observed either by the Linux subreaper or, failing that, by a
+// per-cmd Wait. Exactly one of those two ever waits on a given pid.
+func spawnProc(cfg *Config, proc *ProcInfo) error {
+	cmd := exec.Command("sh", "-c", proc.cmdline)
+	cmd.Env = os.Environ()
+	if proc.setPort {
+		cmd.Env = append(cmd.Env, fmt.Sprintf("PORT=%d", proc.port))
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+
+	proc.mu.Lock()
+	proc.cmd = cmd
+	proc.stoppedBySupervisor = false
+	proc.waitErr = nil
+	proc.exited = false
+	proc.health.restartedAt = time.Now()
+	proc.mu.Unlock()
+
+	go pipeOutput(proc, "stdout", stdout)
+	go pipeOutput(proc, "stderr", stderr)
+
+	if subreaperActive(cfg) {
+		trackPid(cmd.Process.Pid, proc)
+	} else {
+		go waitLegacy(proc)
+	}
+	return nil
+}
+
+// waitLegacy is the non-subreaper fallback: a dedicated per-cmd Wait, used
+// whenever cfg.Subreaper is off (the default off Linux, or explicitly
+// disabled under an existing subreaper like tini or systemd).
+func waitLegacy(proc *ProcInfo) {
+	err := proc.cmd.Wait()
+
+	proc.mu.Lock()
+	proc.waitErr = err
+	proc.exited = true
+	proc.cond.Broadcast()
+	proc.mu.Unlock()
+}
+
+// pipeOutput tees one of a proc's output streams line by line through the
+// structured logger and the Tail fan-out hub.
+func pipeOutput(proc *ProcInfo, stream string, r io.Reader) {
+	logger := log.New(proc.name, "", proc.colorIndex)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		logger.Output(stream, line)
+		globalLogBroker.publish(logLine{proc: proc.name, stream: stream, line: line})
+	}
+}
+
+// stopProc sends sig to proc's own process, marking the stop as
+// supervisor-initiated so the exit monitor does not treat it as a crash.
+func stopProc(proc *ProcInfo, sig syscall.Signal) error {
+	proc.mu.Lock()
+	cmd := proc.cmd
+	proc.stoppedBySupervisor = true
+	proc.mu.Unlock()
+
+	if cmd == nil || cmd.Process == nil {
+		return nil
+	}
+	err := cmd.Process.Signal(sig)
+	if err != nil && errors.Is(err, os.ErrProcessDone) {
+		return nil
+	}
+	return err
+}
+
+// startProc (re)spawns a single stopped proc, honoring the Subreaper
+// setting of the currently running `goreman start`.
+func startProc(proc *ProcInfo) error {
+	if currentCfg == nil {
+		return errors.New("goreman: no running supervisor to start a proc under")
+	}
+	return spawnProc(currentCfg, proc)
+}
+
+// restartProc signals proc and waits for that exact process (not whatever
+// spawnProc's next respawn turns proc.cmd into) to actually be reaped
+// before starting a new one. A bare stopProc-then-startProc would race:
+// spawnProc resets proc.exited/waitErr/stoppedBySupervisor for the new
+// process immediately, so the old process's belated exit would later be
+// reaped against those fields and misattributed to the replacement,
+// potentially tricking shouldRestart into spawning yet another one.
+func restartProc(proc *ProcInfo, sig syscall.Signal) error {
+	proc.mu.Lock()
+	cmd := proc.cmd
+	proc.mu.Unlock()
+
+	if err := stopProc(proc, sig); err != nil {
+		return err
+	}
+
+	if cmd != nil {
+		proc.mu.Lock()
+		for proc.cmd == cmd && !proc.exited {
+			proc.cond.Wait()
+		}
+		proc.mu.Unlock()
+	}
+
+	return startProc(proc)
+}
+
+// shouldRestart reports whether restart should respawn a proc that just
+// exited with waitErr, per policy. Stopped-by-supervisor exits are handled
+// by the caller before shouldRestart is ever consulted.
+func shouldRestart(policy restartPolicy, waitErr error) bool {
+	switch policy.kind {
+	case restartAlways, restartUnlessStopped:
+		return true
+	case restartOnFailure:
+		return waitErr != nil
+	default: // restartNo
+		return false
+	}
+}
+
+// monitorProc owns a single proc's lifecycle after it has first been
+// spawned: it runs the healthcheck supervisor, waits for the proc to exit
+// (however that exit was observed), and consults the restart policy and
+// exponential backoff before respawning.
+func monitorProc(ctx context.Context, cfg *Config, proc *ProcInfo) {
+	logger := log.New(proc.name, "supervisor", proc.colorIndex)
+
+	// The kill signals the process directly rather than through stopProc,
+	// so stoppedBySupervisor stays false and the loop below consults the
+	// restart policy for the exit it causes.
+	go superviseHealth(ctx, proc, &proc.health, func() {
+		proc.mu.Lock()
+		cmd := proc.cmd
+		proc.mu.Unlock()
+		if cmd == nil || cmd.Process == nil {
+			return
+		}
+		if err := cmd.Process.Signal(syscall.SIGTERM); err != nil && !errors.Is(err, os.ErrProcessDone) {
+			logger.Warnf("failed to kill unhealthy proc: %v", err)
+		}
+	})
+
+	for {
+		proc.mu.Lock()
+		for !proc.exited {
+			proc.cond.Wait()
+		}
+		waitErr := proc.waitErr
+		stopped := proc.stoppedBySupervisor
+		proc.mu.Unlock()
+
+		globalEventBroker.publish(procEvent{proc: proc.name, kind: eventExited, ts: time.Now(), detail: errString(waitErr)})
+
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
+
+		if stopped {
+			logger.Infof("stopped; not restarting")
+			return
+		}
+
+		if !shouldRestart(proc.restart, waitErr) {
+			logger.Infof("exited (restart policy %v); not restarting", proc.restart.kind)
+			return
+		}
+
+		proc.mu.Lock()
+		if proc.restart.kind == restartOnFailure && proc.restart.maxRetries > 0 &&
+			proc.health.restartAttempts >= proc.restart.maxRetries {
+			proc.mu.Unlock()
+			logger.Warnf("giving up after %d restart attempts", proc.restart.maxRetries)
+			return
+		}
+		proc.health.restartAttempts++
+		backoff := proc.health.nextBackoff()
+		proc.mu.Unlock()
+
+		logger.Warnf("restarting in %s (policy=%v)", backoff, proc.restart.kind)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+
+		globalEventBroker.publish(procEvent{proc: proc.name, kind: eventRestarted, ts: time.Now()})
+		if err := spawnProc(cfg, proc); err != nil {
+			logger.Errorf("respawn failed: %v", err)
+			return
+		}
+	}
+}
+
+func errString(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// startProcs spawns every configured proc, supervises them for the
+// lifetime of ctx, and services legacy RPC commands delivered over
+// rpcChan. It returns once ctx is cancelled, or once sig fires and every
+// proc has been signalled to stop; procs stopping on their own do not make
+// it return.
+func startProcs(ctx context.Context, sig <-chan os.Signal, rpcChan chan *rpcMessage, cfg *Config) error {
+	currentCfg = cfg
+
+	mu.Lock()
+	targets := append([]*ProcInfo{}, procs...)
+	mu.Unlock()
+
+	for _, proc := range targets {
+		if err := spawnProc(cfg, proc); err != nil {
+			return fmt.Errorf("%s: %w", proc.name, err)
+		}
+		go monitorProc(ctx, cfg, proc)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sig:
+			for _, proc := range targets {
+				stopProc(proc, syscall.SIGTERM)
+			}
+			return nil
+		case msg := <-rpcChan:
+			handleRPCMessage(cfg, msg)
+		}
+	}
+}
@@ -0,0 +1,138 @@
+//go:build linux
+
+package goreman
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// defaultSubreaper is the platform default for Config.Subreaper. Linux is
+// the only platform that supports PR_SET_CHILD_SUBREAPER, so it defaults to
+// on there and off everywhere else.
+const defaultSubreaper = true
+
+// reaper owns the process table used to route SIGCHLD-triggered exits back
+// to the ProcInfo that spawned them, and to silently drain any grandchildren
+// that got reparented to us once we're marked as a child subreaper.
+type reaper struct {
+	mu   sync.Mutex
+	pids map[int]*ProcInfo
+}
+
+var globalReaper = &reaper{pids: map[int]*ProcInfo{}}
+
+// subreaperActive reports whether the Linux subreaper loop is the one
+// responsible for reaping cfg's procs. When false, callers must fall back
+// to a per-cmd Wait instead of registering with the reaper, or the exit
+// will never be observed by either.
+func subreaperActive(cfg *Config) bool {
+	return cfg.Subreaper
+}
+
+// trackPid is the build-tag-neutral entry point proc.go uses right after
+// cmd.Start succeeds.
+func trackPid(pid int, proc *ProcInfo) {
+	globalReaper.track(pid, proc)
+}
+
+// untrackPid undoes trackPid, e.g. if cmd.Start succeeded but the proc is
+// being torn down before it could exit normally.
+func untrackPid(pid int) {
+	globalReaper.untrack(pid)
+}
+
+// track registers proc as the owner of pid so a future reap can route its
+// exit status. Call this immediately after cmd.Start succeeds.
+func (r *reaper) track(pid int, proc *ProcInfo) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pids[pid] = proc
+}
+
+func (r *reaper) untrack(pid int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.pids, pid)
+}
+
+// startSubreaper marks this process as a child subreaper (so orphaned
+// grandchildren are reparented to us instead of PID 1) and launches the
+// central reap loop. It is a no-op once cfg.Subreaper is false.
+func startSubreaper(ctx context.Context, cfg *Config) error {
+	if !cfg.Subreaper {
+		return nil
+	}
+	if err := unix.Prctl(unix.PR_SET_CHILD_SUBREAPER, 1, 0, 0, 0); err != nil {
+		return err
+	}
+
+	sigChld := make(chan os.Signal, 1)
+	signal.Notify(sigChld, syscall.SIGCHLD)
+	go globalReaper.loop(ctx, sigChld)
+	return nil
+}
+
+// loop runs until ctx is cancelled, draining every exited child (ours and
+// any orphaned grandchildren) on each SIGCHLD.
+func (r *reaper) loop(ctx context.Context, sigChld chan os.Signal) {
+	defer signal.Stop(sigChld)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-sigChld:
+			r.reapAll()
+		}
+	}
+}
+
+// reapAll calls wait4(-1, ...) until there is nothing left to reap,
+// dispatching each exit status to the ProcInfo that owns the pid, or
+// silently discarding it if it belongs to an orphaned grandchild.
+func (r *reaper) reapAll() {
+	var ws syscall.WaitStatus
+	var ru syscall.Rusage
+	for {
+		pid, err := syscall.Wait4(-1, &ws, syscall.WNOHANG, &ru)
+		if err != nil || pid <= 0 {
+			return
+		}
+
+		r.mu.Lock()
+		proc, ok := r.pids[pid]
+		if ok {
+			delete(r.pids, pid)
+		}
+		r.mu.Unlock()
+
+		if !ok {
+			// Orphaned grandchild reparented to us; nothing more to do.
+			continue
+		}
+
+		proc.mu.Lock()
+		if ws.ExitStatus() != 0 {
+			proc.waitErr = &exitError{status: ws.ExitStatus()}
+		}
+		proc.exited = true
+		proc.cond.Broadcast()
+		proc.mu.Unlock()
+	}
+}
+
+// exitError mirrors the subset of *os.ExitError that callers of
+// ProcInfo.waitErr rely on, without requiring us to fabricate an os.Process.
+type exitError struct {
+	status int
+}
+
+func (e *exitError) Error() string {
+	return "exit status " + strconv.Itoa(e.status)
+}
@@ -0,0 +1,27 @@
+//go:build !linux
+
+package goreman
+
+import "context"
+
+// defaultSubreaper is false on platforms without PR_SET_CHILD_SUBREAPER;
+// goreman relies on exec.Cmd.Wait for the direct child there.
+const defaultSubreaper = false
+
+// startSubreaper is a no-op outside Linux: there is no child-subreaper
+// facility to opt into, so grandchildren are reaped by whatever process
+// (init, launchd, ...) ends up owning them.
+func startSubreaper(ctx context.Context, cfg *Config) error {
+	return nil
+}
+
+// subreaperActive is always false outside Linux: there is no reap loop
+// here, so proc.go must always fall back to a per-cmd Wait.
+func subreaperActive(cfg *Config) bool {
+	return false
+}
+
+// trackPid/untrackPid are no-ops outside Linux, where subreaperActive is
+// always false and nothing ever registers with a reaper.
+func trackPid(pid int, proc *ProcInfo) {}
+func untrackPid(pid int)               {}
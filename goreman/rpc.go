@@ -0,0 +1,209 @@
+package goreman
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"syscall"
+
+	"github.com/chaos-plus/procman/goreman/log"
+)
+
+// rpcMessage is one command delivered to startProcs' goroutine over
+// rpcChan by a legacy RPC connection. Routing every mutation through that
+// single goroutine means dispatchRPC takes no lock of its own beyond the
+// proc table's mu and the per-proc mu that spawnProc/stopProc already take.
+type rpcMessage struct {
+	command string
+	args    []string
+	replyCh chan rpcReply
+}
+
+type rpcReply struct {
+	text string
+	err  error
+}
+
+// startServer serves the legacy line-based RPC protocol on port until ctx
+// is cancelled: one command per connection, "<command> [arg]\n" in,
+// "OK <text>\n" or "ERR <message>\n" out.
+func startServer(ctx context.Context, rpcChan chan *rpcMessage, port uint) error {
+	addr := fmt.Sprintf("%s:%d", defaultAddr(), port)
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "goreman: rpc server: %v\n", err)
+		return err
+	}
+	logger := log.New("", "rpc", 0)
+	logger.Infof("legacy RPC server listening on %s", addr)
+
+	go func() {
+		<-ctx.Done()
+		lis.Close()
+	}()
+
+	for {
+		conn, err := lis.Accept()
+		if err != nil {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+				logger.Warnf("accept: %v", err)
+				continue
+			}
+		}
+		go handleConn(conn, rpcChan, logger)
+	}
+}
+
+func handleConn(conn net.Conn, rpcChan chan *rpcMessage, logger *log.Logger) {
+	defer conn.Close()
+
+	scanner := bufio.NewScanner(conn)
+	if !scanner.Scan() {
+		return
+	}
+	fields := strings.Fields(scanner.Text())
+	if len(fields) == 0 {
+		fmt.Fprintln(conn, "ERR empty command")
+		return
+	}
+	logger.Debugf("received command %q", fields)
+
+	msg := &rpcMessage{command: fields[0], args: fields[1:], replyCh: make(chan rpcReply, 1)}
+	rpcChan <- msg
+	reply := <-msg.replyCh
+
+	if reply.err != nil {
+		fmt.Fprintf(conn, "ERR %v\n", reply.err)
+		return
+	}
+	fmt.Fprintf(conn, "OK %s\n", reply.text)
+}
+
+// handleRPCMessage runs dispatchRPC and replies to the waiting connection.
+// Only ever called from startProcs' own goroutine.
+func handleRPCMessage(cfg *Config, msg *rpcMessage) {
+	text, err := dispatchRPC(cfg, msg.command, msg.args)
+	msg.replyCh <- rpcReply{text: text, err: err}
+}
+
+// dispatchRPC executes a single legacy RPC command against the running
+// proc table. It also backs `goreman run trace ...`, since trace is just
+// another command forwarded verbatim by run.
+func dispatchRPC(cfg *Config, command string, args []string) (string, error) {
+	switch command {
+	case "list":
+		mu.Lock()
+		defer mu.Unlock()
+		names := make([]string, len(procs))
+		for i, p := range procs {
+			names[i] = p.name
+		}
+		return strings.Join(names, ","), nil
+
+	case "status":
+		mu.Lock()
+		targets := append([]*ProcInfo{}, procs...)
+		mu.Unlock()
+
+		var sb strings.Builder
+		for _, p := range targets {
+			p.mu.Lock()
+			fmt.Fprintf(&sb, "%s running=%v healthy=%v;", p.name, p.cmd != nil && !p.exited, p.health.healthy)
+			p.mu.Unlock()
+		}
+		return sb.String(), nil
+
+	case "start":
+		proc := findProc(arg0(args))
+		if proc == nil {
+			return "", fmt.Errorf("unknown proc: %s", arg0(args))
+		}
+		return "", startProc(proc)
+
+	case "stop":
+		proc := findProc(arg0(args))
+		if proc == nil {
+			return "", fmt.Errorf("unknown proc: %s", arg0(args))
+		}
+		return "", stopProc(proc, syscall.SIGTERM)
+
+	case "restart":
+		proc := findProc(arg0(args))
+		if proc == nil {
+			return "", fmt.Errorf("unknown proc: %s", arg0(args))
+		}
+		return "", restartProc(proc, syscall.SIGTERM)
+
+	case "stop-all":
+		mu.Lock()
+		targets := append([]*ProcInfo{}, procs...)
+		mu.Unlock()
+		for _, p := range targets {
+			if err := stopProc(p, syscall.SIGTERM); err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+
+	case "restart-all":
+		mu.Lock()
+		targets := append([]*ProcInfo{}, procs...)
+		mu.Unlock()
+		for _, p := range targets {
+			if err := restartProc(p, syscall.SIGTERM); err != nil {
+				return "", err
+			}
+		}
+		return "", nil
+
+	case "trace":
+		log.SetFacets(arg0(args))
+		return "trace facets updated", nil
+
+	default:
+		return "", fmt.Errorf("unknown command: %s", command)
+	}
+}
+
+// run is the legacy RPC client: it dials the server on port, sends a
+// single "<cmd> [args...]" line, and prints the response. It backs every
+// `goreman run ...` subcommand when Config.RpcProtocol is "legacy".
+func run(cmd string, args []string, port uint) error {
+	addr := fmt.Sprintf("127.0.0.1:%d", port)
+	conn, err := net.Dial("tcp", addr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	line := cmd
+	if len(args) > 0 {
+		line += " " + strings.Join(args, " ")
+	}
+	fmt.Fprintln(conn, line)
+
+	scanner := bufio.NewScanner(conn)
+	if !scanner.Scan() {
+		return scanner.Err()
+	}
+	resp := scanner.Text()
+	switch {
+	case strings.HasPrefix(resp, "OK"):
+		if text := strings.TrimSpace(strings.TrimPrefix(resp, "OK")); text != "" {
+			fmt.Println(text)
+		}
+		return nil
+	case strings.HasPrefix(resp, "ERR"):
+		return errors.New(strings.TrimSpace(strings.TrimPrefix(resp, "ERR")))
+	default:
+		fmt.Println(resp)
+		return nil
+	}
+}
@@ -0,0 +1,16 @@
+//go:build windows
+
+package goreman
+
+import (
+	"os"
+	"os/signal"
+)
+
+// notifyCh returns a channel fed with the signals that should gracefully
+// stop every running proc and exit goreman itself.
+func notifyCh() <-chan os.Signal {
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	return sig
+}
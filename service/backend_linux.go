@@ -0,0 +1,6 @@
+//go:build linux
+
+package service
+
+// Default returns the service backend for the current platform.
+func Default() Backend { return Systemd{} }
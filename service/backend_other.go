@@ -0,0 +1,15 @@
+//go:build !linux && !darwin && !windows
+
+package service
+
+import "errors"
+
+type unsupported struct{}
+
+func (unsupported) Install(Spec) error   { return errors.New("service: unsupported platform") }
+func (unsupported) Uninstall(Spec) error { return errors.New("service: unsupported platform") }
+func (unsupported) Enable(Spec) error    { return errors.New("service: unsupported platform") }
+func (unsupported) Disable(Spec) error   { return errors.New("service: unsupported platform") }
+
+// Default returns the service backend for the current platform.
+func Default() Backend { return unsupported{} }
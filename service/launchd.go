@@ -0,0 +1,89 @@
+//go:build darwin
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+)
+
+// Launchd installs goreman as a launchd agent under
+// ~/Library/LaunchAgents/, loaded with launchctl.
+type Launchd struct{}
+
+var plistTemplate = template.Must(template.New("plist").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Label</key>
+	<string>{{.Name}}</string>
+	<key>ProgramArguments</key>
+	<array>
+		<string>{{.Executable}}</string>
+		<string>-f</string>
+		<string>{{.Procfile}}</string>
+		<string>-p</string>
+		<string>{{.RpcPort}}</string>
+		<string>start</string>
+	</array>
+	<key>WorkingDirectory</key>
+	<string>{{.WorkDir}}</string>
+	<key>RunAtLoad</key>
+	<true/>
+	<key>KeepAlive</key>
+	<true/>
+</dict>
+</plist>
+`))
+
+func (Launchd) plistPath(spec Spec) string {
+	home, _ := os.UserHomeDir()
+	return filepath.Join(home, "Library", "LaunchAgents", spec.Name+".plist")
+}
+
+func (l Launchd) Install(spec Spec) error {
+	path := l.plistPath(spec)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := plistTemplate.Execute(f, spec); err != nil {
+		return err
+	}
+	return l.launchctl("load", "-w", path)
+}
+
+func (l Launchd) Uninstall(spec Spec) error {
+	path := l.plistPath(spec)
+	if err := l.launchctl("unload", path); err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func (l Launchd) Enable(spec Spec) error {
+	return l.launchctl("load", "-w", l.plistPath(spec))
+}
+
+func (l Launchd) Disable(spec Spec) error {
+	return l.launchctl("unload", "-w", l.plistPath(spec))
+}
+
+func (Launchd) launchctl(args ...string) error {
+	cmd := exec.Command("launchctl", args...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("launchctl %v: %w", args, err)
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// Package service installs goreman itself as a native, boot-time system
+// service so a Procfile-based process group survives reboots without a
+// terminal session keeping `goreman start` alive.
+package service
+
+// Spec describes the service goreman should register with the platform's
+// service manager.
+type Spec struct {
+	// Name is the service's unit/label name, e.g. "goreman-myapp".
+	Name string
+	// Procfile is the absolute path to the Procfile goreman should start.
+	Procfile string
+	// WorkDir is the working directory the service should run from.
+	WorkDir string
+	// RpcPort is the RPC port goreman start should listen on.
+	RpcPort uint
+	// Executable is the absolute path to the goreman binary itself.
+	Executable string
+	// User selects a per-user service when true, or a system-wide one
+	// when false. Only the systemd backend reads it: launchd always
+	// installs a per-user agent and Windows always a system service.
+	User bool
+}
+
+// Backend installs, removes and toggles a Spec with the host platform's
+// native service manager (systemd, launchd, Windows Service Manager, ...).
+type Backend interface {
+	// Install writes the unit/plist/service definition and enables it to
+	// start at boot, but does not necessarily start it immediately.
+	Install(Spec) error
+	// Uninstall removes whatever Install wrote.
+	Uninstall(Spec) error
+	// Enable marks the service to start at boot without installing it
+	// again.
+	Enable(Spec) error
+	// Disable stops the service from starting at boot, without removing
+	// its definition.
+	Disable(Spec) error
+}
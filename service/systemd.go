@@ -0,0 +1,84 @@
+//go:build linux
+
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"text/template"
+)
+
+// Systemd installs goreman as a systemd unit, either a per-user unit under
+// ~/.config/systemd/user/ or a system-wide one under /etc/systemd/system/.
+type Systemd struct{}
+
+var unitTemplate = template.Must(template.New("unit").Parse(`[Unit]
+Description=goreman supervisor for {{.Procfile}}
+After=network.target
+
+[Service]
+Type=simple
+WorkingDirectory={{.WorkDir}}
+ExecStart={{.Executable}} -f {{.Procfile}} -p {{.RpcPort}} start
+Restart=on-failure
+
+[Install]
+WantedBy={{if .User}}default.target{{else}}multi-user.target{{end}}
+`))
+
+func (Systemd) unitPath(spec Spec) string {
+	if spec.User {
+		home, _ := os.UserHomeDir()
+		return filepath.Join(home, ".config", "systemd", "user", spec.Name+".service")
+	}
+	return filepath.Join("/etc/systemd/system", spec.Name+".service")
+}
+
+func (s Systemd) Install(spec Spec) error {
+	path := s.unitPath(spec)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	if err := unitTemplate.Execute(f, spec); err != nil {
+		return err
+	}
+	if err := s.systemctl(spec, "daemon-reload"); err != nil {
+		return err
+	}
+	return s.systemctl(spec, "enable", spec.Name)
+}
+
+func (s Systemd) Uninstall(spec Spec) error {
+	if err := os.Remove(s.unitPath(spec)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return s.systemctl(spec, "daemon-reload")
+}
+
+func (s Systemd) Enable(spec Spec) error {
+	return s.systemctl(spec, "enable", spec.Name)
+}
+
+func (s Systemd) Disable(spec Spec) error {
+	return s.systemctl(spec, "disable", spec.Name)
+}
+
+func (Systemd) systemctl(spec Spec, args ...string) error {
+	cmdArgs := args
+	if spec.User {
+		cmdArgs = append([]string{"--user"}, args...)
+	}
+	cmd := exec.Command("systemctl", cmdArgs...)
+	cmd.Stdout, cmd.Stderr = os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("systemctl %v: %w", cmdArgs, err)
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+//go:build linux
+
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSystemdUnitPathUser(t *testing.T) {
+	spec := Spec{Name: "goreman-myapp", User: true}
+	path := Systemd{}.unitPath(spec)
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := filepath.Join(home, ".config", "systemd", "user", "goreman-myapp.service")
+	if path != want {
+		t.Fatalf("unitPath(%+v) = %q, want %q", spec, path, want)
+	}
+}
+
+func TestSystemdUnitPathSystem(t *testing.T) {
+	spec := Spec{Name: "goreman-myapp", User: false}
+	path := Systemd{}.unitPath(spec)
+
+	want := filepath.Join("/etc/systemd/system", "goreman-myapp.service")
+	if path != want {
+		t.Fatalf("unitPath(%+v) = %q, want %q", spec, path, want)
+	}
+}
+
+func TestUnitTemplateSelectsWantedByTarget(t *testing.T) {
+	var sb strings.Builder
+	if err := unitTemplate.Execute(&sb, Spec{
+		Name: "goreman-myapp", Procfile: "/srv/app/Procfile", WorkDir: "/srv/app",
+		RpcPort: 8555, Executable: "/usr/bin/goreman", User: true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), "WantedBy=default.target") {
+		t.Fatalf("expected a user unit to want default.target, got:\n%s", sb.String())
+	}
+
+	sb.Reset()
+	if err := unitTemplate.Execute(&sb, Spec{User: false}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), "WantedBy=multi-user.target") {
+		t.Fatalf("expected a system unit to want multi-user.target, got:\n%s", sb.String())
+	}
+}
@@ -0,0 +1,82 @@
+//go:build windows
+
+package service
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// Windows installs goreman as a Windows service via the Service Control
+// Manager.
+type Windows struct{}
+
+func (Windows) Install(spec Spec) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	// mgr.Config has no working-directory field and the SCM doesn't honor
+	// one, so the service binary is cmd.exe itself: it cds into WorkDir
+	// before exec'ing goreman, giving goreman a correct os.Getwd().
+	args := []string{
+		"/c", "cd", "/d", spec.WorkDir, "&&",
+		spec.Executable, "-f", spec.Procfile, "-p", fmt.Sprint(spec.RpcPort), "start",
+	}
+	s, err := m.CreateService(spec.Name, "cmd.exe", mgr.Config{
+		DisplayName: spec.Name,
+		StartType:   mgr.StartAutomatic,
+	}, args...)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	return nil
+}
+
+func (Windows) Uninstall(spec Spec) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(spec.Name)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+	return s.Delete()
+}
+
+func (w Windows) Enable(spec Spec) error {
+	return w.setStartType(spec, mgr.StartAutomatic)
+}
+
+func (w Windows) Disable(spec Spec) error {
+	return w.setStartType(spec, mgr.StartDisabled)
+}
+
+func (Windows) setStartType(spec Spec, startType uint32) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return err
+	}
+	defer m.Disconnect()
+
+	s, err := m.OpenService(spec.Name)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	cfg, err := s.Config()
+	if err != nil {
+		return err
+	}
+	cfg.StartType = startType
+	return s.UpdateConfig(cfg)
+}